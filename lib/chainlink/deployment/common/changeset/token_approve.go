@@ -1,30 +1,59 @@
 package changeset
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 
+	chainsel "github.com/smartcontractkit/chain-selectors"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 	cldf "github.com/smartcontractkit/chainlink-deployments-framework/deployment"
 	"github.com/smartcontractkit/chainlink-evm/gethwrappers/shared/generated/erc20"
 )
 
-// ApproveToken approves the router to spend the given amount of tokens
-func ApproveToken(env cldf.Environment, src uint64, tokenAddress common.Address, routerAddress common.Address, amount *big.Int) error {
-	token, err := erc20.NewERC20(tokenAddress, env.Chains[src].Client)
+// TokenApprover approves a router to spend tokens on behalf of a deployer, for a specific chain
+// family. Register new families in TokenApprovers rather than adding branches to ApproveToken.
+type TokenApprover interface {
+	Approve(env cldf.Environment, chainSelector uint64, token, router cciptypes.UnknownAddress, amount *big.Int) error
+}
+
+// TokenApprovers maps a chain family (e.g. chainsel.FamilyEVM) to the TokenApprover responsible
+// for it, mirroring the family-dispatch pattern used by common.AddressCodec's
+// registeredAddressCodecMap.
+var TokenApprovers = map[string]TokenApprover{
+	chainsel.FamilyEVM: evmTokenApprover{},
+}
+
+// ApproveToken approves the router to spend the given amount of tokens, dispatching to the
+// TokenApprover registered for the chain family that chainSelector belongs to.
+func ApproveToken(env cldf.Environment, chainSelector uint64, token, router cciptypes.UnknownAddress, amount *big.Int) error {
+	family, err := chainsel.GetSelectorFamily(chainSelector)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to get chain family for selector %d: %w", chainSelector, err)
 	}
 
-	tx, err := token.Approve(env.Chains[src].DeployerKey, routerAddress, amount)
+	approver, ok := TokenApprovers[family]
+	if !ok {
+		return fmt.Errorf("no TokenApprover registered for chain family %s", family)
+	}
+
+	return approver.Approve(env, chainSelector, token, router, amount)
+}
+
+type evmTokenApprover struct{}
+
+func (evmTokenApprover) Approve(env cldf.Environment, chainSelector uint64, tokenAddress, routerAddress cciptypes.UnknownAddress, amount *big.Int) error {
+	token, err := erc20.NewERC20(common.BytesToAddress(tokenAddress), env.Chains[chainSelector].Client)
 	if err != nil {
 		return err
 	}
 
-	_, err = env.Chains[src].Confirm(tx)
+	tx, err := token.Approve(env.Chains[chainSelector].DeployerKey, common.BytesToAddress(routerAddress), amount)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	_, err = env.Chains[chainSelector].Confirm(tx)
+	return err
 }
@@ -0,0 +1,103 @@
+package changeset
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	cldf "github.com/smartcontractkit/chainlink-deployments-framework/deployment"
+	"github.com/smartcontractkit/chainlink-deployments-framework/deployment/timelock"
+	"github.com/smartcontractkit/mcms"
+)
+
+// erc20ApproveABI is the minimal ERC20 ABI needed to encode an approve(address,uint256) call,
+// so ApproveTokenProposal doesn't need a bound erc20.ERC20 transactor (which always signs and
+// sends immediately, rather than just returning calldata for an MCMS batch).
+const erc20ApproveABI = `[{"constant":false,"inputs":[{"name":"spender","type":"address"},{"name":"amount","type":"uint256"}],"name":"approve","outputs":[{"name":"","type":"bool"}],"type":"function"}]`
+
+// ApproveTokenProposal builds the timelock batch operation for approving the router to spend the
+// given amount of tokens, for use when the token/router owner is a timelocked MCMS contract
+// rather than a plain deployer key (see ApproveToken for the direct-signing counterpart).
+func ApproveTokenProposal(
+	env cldf.Environment,
+	src uint64,
+	tokenAddress common.Address,
+	routerAddress common.Address,
+	amount *big.Int,
+) (timelock.BatchChainOperation, error) {
+	erc20ABI, err := abi.JSON(strings.NewReader(erc20ApproveABI))
+	if err != nil {
+		return timelock.BatchChainOperation{}, err
+	}
+
+	data, err := erc20ABI.Pack("approve", routerAddress, amount)
+	if err != nil {
+		return timelock.BatchChainOperation{}, err
+	}
+
+	return timelock.BatchChainOperation{
+		ChainSelector: src,
+		Batch: []mcms.Operation{
+			{
+				To:    tokenAddress,
+				Data:  data,
+				Value: big.NewInt(0),
+			},
+		},
+	}, nil
+}
+
+// BuildProposal aggregates one or more timelock batch operations (e.g. from ApproveTokenProposal
+// and any other pending on-chain changes in the same changeset run) into a single MCMS proposal
+// for signing, computing the per-chain min delay and predecessor from the on-chain timelock state.
+func BuildProposal(env cldf.Environment, ops ...timelock.BatchChainOperation) (*timelock.MCMSWithTimelockProposal, error) {
+	return timelock.NewMCMSWithTimelockProposal(env, ops)
+}
+
+// TokenApprovalMode selects which of the two token approval paths a caller should use for a given
+// chain: direct deployer-key signing (ApproveToken), or building an MCMS/timelock batch for later
+// execution (ApproveTokenProposal).
+type TokenApprovalMode int
+
+const (
+	// TokenApprovalDirect signs and sends the approve tx immediately with the deployer key. Use
+	// this while the token/router is still owned by the deployer, not a timelock.
+	TokenApprovalDirect TokenApprovalMode = iota
+	// TokenApprovalMCMSProposal builds a timelock batch operation instead of sending a tx, for
+	// chains where ownership has already been handed off to an MCMS/timelock contract.
+	TokenApprovalMCMSProposal
+)
+
+// ApproveTokenWithMode dispatches to ApproveToken or ApproveTokenProposal based on mode, giving
+// callers that manage a mix of deployer-owned and timelocked chains an explicit way to say which
+// path a given chain needs, rather than having to duplicate that dispatch at every call site.
+// The returned op is the MCMS batch to pass to BuildProposal; it's nil for TokenApprovalDirect.
+//
+// TODO: mode still has to be chosen by the caller out-of-band per chain; ViewDataStreams doesn't
+// yet surface the timelock/MCMS owner address that would let a caller derive it automatically
+// (see the TODO on data-streams/changeset.ViewDataStreamsChain).
+func ApproveTokenWithMode(
+	env cldf.Environment,
+	mode TokenApprovalMode,
+	chainSelector uint64,
+	token cciptypes.UnknownAddress,
+	router cciptypes.UnknownAddress,
+	amount *big.Int,
+) (op *timelock.BatchChainOperation, err error) {
+	switch mode {
+	case TokenApprovalDirect:
+		return nil, ApproveToken(env, chainSelector, token, router, amount)
+	case TokenApprovalMCMSProposal:
+		batchOp, err := ApproveTokenProposal(env, chainSelector, common.BytesToAddress(token), common.BytesToAddress(router), amount)
+		if err != nil {
+			return nil, err
+		}
+		return &batchOp, nil
+	default:
+		return nil, fmt.Errorf("unknown token approval mode: %d", mode)
+	}
+}
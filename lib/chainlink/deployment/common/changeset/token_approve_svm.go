@@ -0,0 +1,34 @@
+package changeset
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	cldf "github.com/smartcontractkit/chainlink-deployments-framework/deployment"
+)
+
+func init() {
+	TokenApprovers[chainsel.FamilySolana] = svmTokenApprover{}
+}
+
+// ErrSVMApprovalNotImplemented is returned by svmTokenApprover.Approve. TokenApprovers registers
+// svmTokenApprover for FamilySolana so ApproveToken/ApproveTokenWithMode dispatch to it like any
+// other family rather than erroring at config time with "no TokenApprover registered" -- but
+// callers that batch approvals across chains and want to skip or flag Solana ahead of time rather
+// than failing partway through a batch can check errors.Is(err, ErrSVMApprovalNotImplemented).
+var ErrSVMApprovalNotImplemented = errors.New("SVM token approval is not yet implemented")
+
+// svmTokenApprover issues an SPL token approve instruction instead of an ERC20 approve call.
+//
+// This checkout doesn't carry the Solana chain client (env.BlockChains.SolanaChains()) that this
+// would need to build and send the approve instruction, so Approve returns
+// ErrSVMApprovalNotImplemented rather than a fabricated implementation; wire it up once that
+// client is available here. This is a tracked placeholder, not complete FamilySolana support.
+type svmTokenApprover struct{}
+
+func (svmTokenApprover) Approve(_ cldf.Environment, chainSelector uint64, _, _ cciptypes.UnknownAddress, _ *big.Int) error {
+	return fmt.Errorf("chain selector %d: %w", chainSelector, ErrSVMApprovalNotImplemented)
+}
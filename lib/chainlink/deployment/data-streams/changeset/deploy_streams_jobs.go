@@ -0,0 +1,275 @@
+package changeset
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/google/uuid"
+	jobv1 "github.com/smartcontractkit/chainlink-protos/job-distributor/v1/job"
+	"github.com/smartcontractkit/chainlink-protos/job-distributor/v1/shared/ptypes"
+
+	cldf "github.com/smartcontractkit/chainlink-deployments-framework/deployment"
+
+	"github.com/smartcontractkit/chainlink/deployment/data-streams/jd"
+	dsjobs "github.com/smartcontractkit/chainlink/deployment/data-streams/jobs"
+	"github.com/smartcontractkit/chainlink/deployment/data-streams/utils"
+	"github.com/smartcontractkit/chainlink/deployment/data-streams/utils/pointer"
+)
+
+var _ cldf.ChangeSetV2[DeployStreamsJobsConfig] = CsDeployStreamsJobs{}
+
+// jobKindLabelKey marks a node's bootstrap/LLO job so CsDeployStreamsJobs can tell it's already
+// deployed without needing to track externalJobIDs out of band.
+const jobKindLabelKey = "job-kind"
+
+// DeployStreamsJobsConfig is the configuration for CsDeployStreamsJobs.
+type DeployStreamsJobsConfig struct {
+	// Filter and NodeNames select the oracle nodes that the LLO and stream jobs in Spec are
+	// deployed to.
+	Filter    *jd.ListFilter
+	NodeNames []string
+
+	// BootstrapFilter and BootstrapNodeNames select the bootstrap node(s) that Spec.Bootstrap is
+	// deployed to. Bootstrap nodes are a distinct set from the oracle nodes in Filter/NodeNames,
+	// so these are required whenever Spec.Bootstrap is set.
+	BootstrapFilter    *jd.ListFilter
+	BootstrapNodeNames []string
+
+	// Spec describes the bootstrap/LLO/stream job specs to deploy.
+	Spec dsjobs.JobSpecConfig
+
+	// DryRun, when true, only renders the TOML for every spec and writes it under OutputDir
+	// instead of proposing and approving anything through the Job Distributor.
+	DryRun bool
+	// OutputDir is where rendered TOML is written when DryRun is true.
+	OutputDir string
+}
+
+type CsDeployStreamsJobs struct{}
+
+func (CsDeployStreamsJobs) Apply(e cldf.Environment, cfg DeployStreamsJobsConfig) (cldf.ChangesetOutput, error) {
+	ctx := e.GetContext()
+
+	var deployedJobs []cldf.ProposedJob
+
+	if cfg.Spec.Bootstrap != nil {
+		bootstrapNodes, err := jd.FetchDONOraclesFromJD(ctx, e.Offchain, cfg.BootstrapFilter, cfg.BootstrapNodeNames)
+		if err != nil {
+			return cldf.ChangesetOutput{}, fmt.Errorf("failed to get bootstrap nodes: %w", err)
+		}
+		for _, n := range bootstrapNodes {
+			spec, err := renderBootstrapJobSpec(*cfg.Spec.Bootstrap)
+			if err != nil {
+				return cldf.ChangesetOutput{}, fmt.Errorf("failed to render bootstrap job spec for node %s: %w", n.Id, err)
+			}
+			job, err := deployNodeSpec(ctx, e, cfg, n.Id, spec)
+			if err != nil {
+				return cldf.ChangesetOutput{}, err
+			}
+			if job != nil {
+				deployedJobs = append(deployedJobs, *job)
+			}
+		}
+	}
+
+	if cfg.Spec.LLO != nil || len(cfg.Spec.Streams) > 0 {
+		oracleNodes, err := jd.FetchDONOraclesFromJD(ctx, e.Offchain, cfg.Filter, cfg.NodeNames)
+		if err != nil {
+			return cldf.ChangesetOutput{}, fmt.Errorf("failed to get oracle nodes: %w", err)
+		}
+		for _, n := range oracleNodes {
+			specs, err := renderOracleJobSpecs(cfg.Spec)
+			if err != nil {
+				return cldf.ChangesetOutput{}, fmt.Errorf("failed to render job specs for node %s: %w", n.Id, err)
+			}
+			for _, spec := range specs {
+				job, err := deployNodeSpec(ctx, e, cfg, n.Id, spec)
+				if err != nil {
+					return cldf.ChangesetOutput{}, err
+				}
+				if job != nil {
+					deployedJobs = append(deployedJobs, *job)
+				}
+			}
+		}
+	}
+
+	return cldf.ChangesetOutput{
+		Jobs: deployedJobs,
+	}, nil
+}
+
+func (CsDeployStreamsJobs) VerifyPreconditions(_ cldf.Environment, config DeployStreamsJobsConfig) error {
+	if config.Filter == nil {
+		return fmt.Errorf("filter is required")
+	}
+	if len(config.NodeNames) == 0 {
+		return fmt.Errorf("at least one node name is required")
+	}
+	if config.Spec.Bootstrap == nil && config.Spec.LLO == nil && len(config.Spec.Streams) == 0 {
+		return fmt.Errorf("spec must include at least one of bootstrap, llo or streams")
+	}
+	if config.Spec.Bootstrap != nil {
+		if config.BootstrapFilter == nil {
+			return fmt.Errorf("bootstrapFilter is required when spec.bootstrap is set")
+		}
+		if len(config.BootstrapNodeNames) == 0 {
+			return fmt.Errorf("at least one bootstrap node name is required when spec.bootstrap is set")
+		}
+	}
+	return nil
+}
+
+// renderedJobSpec is a single job spec rendered to TOML, along with the label used to detect
+// whether it's already deployed on a node.
+type renderedJobSpec struct {
+	kind dsjobs.JobSpecKind
+	// name disambiguates specs of the same kind on a node (e.g. several stream jobs) for the
+	// dry-run output filename; it's not necessarily unique across kinds.
+	name  string
+	label *ptypes.Label
+	toml  string
+}
+
+// renderBootstrapJobSpec renders the bootstrap job spec described by cfg, suitable for proposing
+// identically to every bootstrap node in the DON.
+func renderBootstrapJobSpec(cfg dsjobs.BootstrapJobSpecConfig) (renderedJobSpec, error) {
+	spec, err := dsjobs.BootstrapJobSpecGenerator{}.GenerateJobSpec(cfg, uuid.Nil)
+	if err != nil {
+		return renderedJobSpec{}, fmt.Errorf("failed to generate bootstrap job spec: %w", err)
+	}
+	rendered, err := spec.MarshalTOML()
+	if err != nil {
+		return renderedJobSpec{}, fmt.Errorf("failed to marshal bootstrap job spec: %w", err)
+	}
+	return renderedJobSpec{
+		kind:  dsjobs.JobSpecKindBootstrap,
+		name:  string(dsjobs.JobSpecKindBootstrap),
+		label: &ptypes.Label{Key: jobKindLabelKey, Value: pointer.To(string(dsjobs.JobSpecKindBootstrap))},
+		toml:  string(rendered),
+	}, nil
+}
+
+// renderOracleJobSpecs renders the LLO job spec (if configured) and every stream job spec
+// described by cfg, suitable for proposing identically to every oracle node in the DON.
+func renderOracleJobSpecs(cfg dsjobs.JobSpecConfig) ([]renderedJobSpec, error) {
+	var specs []renderedJobSpec
+
+	if cfg.LLO != nil {
+		spec, err := dsjobs.LLOJobSpecGenerator{}.GenerateJobSpec(*cfg.LLO, uuid.Nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate LLO job spec: %w", err)
+		}
+		rendered, err := spec.MarshalTOML()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal LLO job spec: %w", err)
+		}
+		specs = append(specs, renderedJobSpec{
+			kind:  dsjobs.JobSpecKindLLO,
+			name:  string(dsjobs.JobSpecKindLLO),
+			label: &ptypes.Label{Key: jobKindLabelKey, Value: pointer.To(string(dsjobs.JobSpecKindLLO))},
+			toml:  string(rendered),
+		})
+	}
+
+	for _, s := range cfg.Streams {
+		generator := s.Generator
+		if generator == nil {
+			var err error
+			generator, err = dsjobs.GeneratorForStreamType(s.StreamType)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get generator for stream type %s: %w", s.StreamType, err)
+			}
+		}
+		spec, err := generator.GenerateJobSpec(s, uuid.Nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate stream job spec for %s: %w", s.Name, err)
+		}
+		rendered, err := spec.MarshalTOML()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal stream job spec for %s: %w", s.Name, err)
+		}
+		specs = append(specs, renderedJobSpec{
+			kind:  dsjobs.JobSpecKindStream,
+			name:  fmt.Sprintf("stream-%d", s.StreamID),
+			label: utils.StreamIDValueLabel(s.StreamID),
+			toml:  string(rendered),
+		})
+	}
+
+	return specs, nil
+}
+
+// deployNodeSpec proposes (or, in DryRun, writes to disk) a single rendered job spec for one
+// node, skipping it if that node already has a job carrying the spec's label.
+func deployNodeSpec(ctx context.Context, e cldf.Environment, cfg DeployStreamsJobsConfig, nodeID string, spec renderedJobSpec) (*cldf.ProposedJob, error) {
+	if cfg.DryRun {
+		if err := writeRenderedSpec(cfg.OutputDir, nodeID, spec); err != nil {
+			return nil, fmt.Errorf("failed to write rendered %s spec for node %s: %w", spec.kind, nodeID, err)
+		}
+		return nil, nil
+	}
+
+	alreadyDeployed, err := nodeHasJobWithLabel(e, nodeID, spec.label)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for an existing %s job on node %s: %w", spec.kind, nodeID, err)
+	}
+	if alreadyDeployed {
+		return nil, nil
+	}
+
+	proposeResp, err := e.Offchain.ProposeJob(ctx, &jobv1.ProposeJobRequest{
+		NodeId: nodeID,
+		Spec:   spec.toml,
+		Labels: []*ptypes.Label{spec.label},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to propose %s job on node %s: %w", spec.kind, nodeID, err)
+	}
+
+	if _, err := e.Offchain.ApproveJobProposal(ctx, &jobv1.ApproveJobProposalRequest{
+		Id: proposeResp.GetProposal().GetId(),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to approve %s job proposal on node %s: %w", spec.kind, nodeID, err)
+	}
+
+	return &cldf.ProposedJob{
+		JobID: proposeResp.GetProposal().GetJobId(),
+		Spec:  proposeResp.GetProposal().GetSpec(),
+	}, nil
+}
+
+// nodeHasJobWithLabel reports whether a node already has a job carrying the given label,
+// making CsDeployStreamsJobs idempotent across repeated runs.
+func nodeHasJobWithLabel(e cldf.Environment, nodeID string, label *ptypes.Label) (bool, error) {
+	resp, err := e.Offchain.ListJobs(e.GetContext(), &jobv1.ListJobsRequest{
+		Filter: &jobv1.ListJobsRequest_Filter{
+			NodeIds: []string{nodeID},
+			Selectors: []*ptypes.Selector{
+				{
+					Key:   label.GetKey(),
+					Op:    ptypes.SelectorOp_EQ,
+					Value: label.Value,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(resp.Jobs) > 0, nil
+}
+
+// writeRenderedSpec writes a dry-run spec's TOML to <dir>/<nodeID>-<name>.toml.
+func writeRenderedSpec(dir string, nodeID string, spec renderedJobSpec) error {
+	if dir == "" {
+		dir = "."
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create output dir: %w", err)
+	}
+	path := filepath.Join(dir, fmt.Sprintf("%s-%s.toml", nodeID, spec.name))
+	return os.WriteFile(path, []byte(spec.toml), 0o644)
+}
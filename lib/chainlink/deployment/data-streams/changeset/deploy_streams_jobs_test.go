@@ -0,0 +1,48 @@
+package changeset
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dsjobs "github.com/smartcontractkit/chainlink/deployment/data-streams/jobs"
+)
+
+func TestWriteRenderedSpecKeysFilenameOffNameNotKind(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	specs := []renderedJobSpec{
+		{kind: dsjobs.JobSpecKindStream, name: "stream-1", toml: "streamID = 1"},
+		{kind: dsjobs.JobSpecKindStream, name: "stream-2", toml: "streamID = 2"},
+	}
+
+	for _, spec := range specs {
+		require.NoError(t, writeRenderedSpec(dir, "node-1", spec))
+	}
+
+	for _, spec := range specs {
+		content, err := os.ReadFile(filepath.Join(dir, "node-1-"+spec.name+".toml"))
+		require.NoError(t, err)
+		require.Equal(t, spec.toml, string(content))
+	}
+}
+
+func TestWriteRenderedSpecDefaultsToCurrentDir(t *testing.T) {
+	dir := t.TempDir()
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	defer func() { require.NoError(t, os.Chdir(wd)) }()
+
+	spec := renderedJobSpec{kind: dsjobs.JobSpecKindBootstrap, name: string(dsjobs.JobSpecKindBootstrap), toml: "bootstrap"}
+	require.NoError(t, writeRenderedSpec("", "node-1", spec))
+
+	content, err := os.ReadFile(filepath.Join(dir, "node-1-bootstrap.toml"))
+	require.NoError(t, err)
+	require.Equal(t, "bootstrap", string(content))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"strconv"
 
 	jobv1 "github.com/smartcontractkit/chainlink-protos/job-distributor/v1/job"
@@ -27,6 +28,26 @@ type CsDistributeStreamJobSpecsConfig struct {
 
 	// NodeNames specifies on which nodes to distribute the job specs.
 	NodeNames []string
+
+	// DryRun, when true, renders every ProposeJobRequest that would be sent to the Job
+	// Distributor without calling Offchain.ProposeJob. Each rendered proposal's marshaled TOML is
+	// returned via ChangesetOutput.Jobs as Spec, with an empty JobID, so operators can diff them
+	// against what is currently deployed before committing. The returned entries do not include
+	// the proposal's target node or its labels.
+	//
+	// TODO: these previews should come back via a distinct ChangesetOutput.PreviewedJobs field
+	// instead of reusing Jobs, so a caller can't mistake a preview for a committed proposal. That
+	// needs a field added to cldf.ChangesetOutput, which is owned by the
+	// chainlink-deployments-framework module rather than this repo.
+	DryRun bool
+
+	// Concurrency bounds how many (stream, node) pairs are built and proposed in parallel.
+	// Defaults to 1 (fully sequential, matching the previous behavior) if unset.
+	Concurrency int
+
+	// RetryPolicy controls how a failed ProposeJob call for a single node is retried before the
+	// whole changeset is considered failed. Defaults to a single attempt if unset.
+	RetryPolicy RetryPolicy
 }
 
 type CsDistributeStreamJobSpecs struct{}
@@ -51,71 +72,73 @@ func (CsDistributeStreamJobSpecs) Apply(e cldf.Environment, cfg CsDistributeStre
 		return cldf.ChangesetOutput{}, fmt.Errorf("failed to get oracle nodes: %w", err)
 	}
 
-	var proposals []*jobv1.ProposeJobRequest
+	var builders []buildProposalFunc
 	for _, s := range cfg.Streams {
-		// Start with the common labels.
-		streamLabels := append([]*ptypes.Label{}, cfg.Labels...)
-		// Some streams might not have an ID.
-		if s.StreamID > 0 {
-			streamLabels = append(streamLabels, &ptypes.Label{
-				Key:   utils.StreamIDLabel(s.StreamID),
-				Value: pointer.To(s.Name),
-			})
-		}
-		virtualStreamIDLabels, err := streamIDLabelsFromReportFields(s.ReportFields)
+		s := s
+
+		streamID, streamIDLabels, err := resolveStreamIDAndLabels(s)
 		if err != nil {
-			return cldf.ChangesetOutput{}, fmt.Errorf("failed to get streamID labels: %w", err)
+			return cldf.ChangesetOutput{}, fmt.Errorf("failed to resolve streamID for stream %s: %w", s.Name, err)
+		}
+		streamLabels := append(append([]*ptypes.Label{}, cfg.Labels...), streamIDLabels...)
+
+		if s.Generator == nil {
+			s.Generator, err = jobs.GeneratorForStreamType(s.StreamType)
+			if err != nil {
+				return cldf.ChangesetOutput{}, fmt.Errorf("failed to get generator for stream type %s: %w", s.StreamType, err)
+			}
 		}
-		streamLabels = append(streamLabels, virtualStreamIDLabels...)
 
 		for _, n := range oracleNodes {
-			// Check if there is already a job spec for this stream on this node:
-			streamID := s.StreamID
-			if streamID == 0 {
-				if len(virtualStreamIDLabels) == 0 {
-					return cldf.ChangesetOutput{}, fmt.Errorf("no top level or virtual streamID found for stream %s", s.Name)
-				}
-				streamID, err = utils.StreamIDFromLabel(virtualStreamIDLabels[0].Key)
+			n := n
+			builders = append(builders, func() (*jobv1.ProposeJobRequest, error) {
+				// Check if there is already a job spec for this stream on this node:
+				externalJobID, err := fetchExternalJobID(e, n.Id, []*ptypes.Selector{
+					{
+						Key: utils.StreamIDLabel(streamID),
+						Op:  ptypes.SelectorOp_EXIST,
+					},
+				})
 				if err != nil {
-					return cldf.ChangesetOutput{}, fmt.Errorf("failed to parse streamID from label: %w", err)
+					return nil, fmt.Errorf("failed to get externalJobID: %w", err)
 				}
-			}
-			// Check if there is already a job spec for this stream on this node:
-			externalJobID, err := fetchExternalJobID(e, n.Id, []*ptypes.Selector{
-				{
-					Key: utils.StreamIDLabel(streamID),
-					Op:  ptypes.SelectorOp_EXIST,
-				},
-			})
-			if err != nil {
-				return cldf.ChangesetOutput{}, fmt.Errorf("failed to get externalJobID: %w", err)
-			}
 
-			if s.Generator == nil {
-				s.Generator, err = jobs.GeneratorForStreamType(s.StreamType)
+				spec, err := s.Generator.GenerateJobSpec(s, externalJobID)
 				if err != nil {
-					return cldf.ChangesetOutput{}, fmt.Errorf("failed to get generator for stream type %s: %w", s.StreamType, err)
+					return nil, fmt.Errorf("failed to create stream job spec: %w", err)
+				}
+				renderedSpec, err := spec.MarshalTOML()
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal stream job spec: %w", err)
 				}
-			}
-			spec, err := s.Generator.GenerateJobSpec(s, externalJobID)
 
-			if err != nil {
-				return cldf.ChangesetOutput{}, fmt.Errorf("failed to create stream job spec: %w", err)
-			}
-			renderedSpec, err := spec.MarshalTOML()
-			if err != nil {
-				return cldf.ChangesetOutput{}, fmt.Errorf("failed to marshal stream job spec: %w", err)
-			}
+				return &jobv1.ProposeJobRequest{
+					NodeId: n.Id,
+					Spec:   string(renderedSpec),
+					Labels: streamLabels,
+				}, nil
+			})
+		}
+	}
+
+	proposals, err := buildProposalsConcurrently(cfg.Concurrency, builders)
+	if err != nil {
+		return cldf.ChangesetOutput{}, fmt.Errorf("failed to build stream job proposals: %w", err)
+	}
 
-			proposals = append(proposals, &jobv1.ProposeJobRequest{
-				NodeId: n.Id,
-				Spec:   string(renderedSpec),
-				Labels: streamLabels,
+	if cfg.DryRun {
+		previewedJobs := make([]cldf.ProposedJob, 0, len(proposals))
+		for _, p := range proposals {
+			previewedJobs = append(previewedJobs, cldf.ProposedJob{
+				Spec: p.Spec,
 			})
 		}
+		return cldf.ChangesetOutput{
+			Jobs: previewedJobs,
+		}, nil
 	}
 
-	proposedJobs, err := proposeAllOrNothing(ctx, e.Offchain, proposals)
+	proposedJobs, err := proposeConcurrentlyWithRetry(ctx, e, proposals, cfg.Concurrency, cfg.RetryPolicy)
 	if err != nil {
 		return cldf.ChangesetOutput{}, fmt.Errorf("failed to propose all oracle jobs: %w", err)
 	}
@@ -125,38 +148,56 @@ func (CsDistributeStreamJobSpecs) Apply(e cldf.Environment, cfg CsDistributeStre
 	}, nil
 }
 
-// streamIDLabelsFromReportFields returns a list of labels for the virtual streamIDs from the report fields.
-// This function does NOT return nil, it returns an empty slice if no labels are found.
-func streamIDLabelsFromReportFields(rf jobs.ReportFields) ([]*ptypes.Label, error) {
-	labels := []*ptypes.Label{}
+// resolveStreamIDAndLabels computes the effective stream ID for s and the full set of stream-id
+// labels (top-level and/or virtual) that identify it. If s.StreamID is unset, the effective ID is
+// taken from the first virtual stream ID declared by s.ReportFields (e.g. a Quote stream's
+// benchmark/bid/ask sub-IDs), matching the "streams might not have a top-level ID" shape that
+// CsDistributeStreamJobSpecs and CsReconcileStreamJobSpecs both need to look up and label jobs
+// identically for.
+func resolveStreamIDAndLabels(s jobs.StreamSpecConfig) (streamID uint32, labels []*ptypes.Label, err error) {
+	if s.StreamID > 0 {
+		labels = append(labels,
+			&ptypes.Label{
+				Key:   utils.StreamIDLabel(s.StreamID),
+				Value: pointer.To(s.Name),
+			},
+			utils.StreamIDValueLabel(s.StreamID),
+		)
+	}
 
-	switch rf := rf.(type) {
-	case jobs.MedianReportFields:
-		l, err := streamIDLabelsFor(rf.Benchmark.StreamID)
-		if err != nil {
-			return nil, err
-		}
-		labels = append(labels, l...)
+	virtualStreamIDLabels, err := streamIDLabelsFromReportFields(s.ReportFields)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to get streamID labels: %w", err)
+	}
+	labels = append(labels, virtualStreamIDLabels...)
 
-	case jobs.QuoteReportFields:
-		l, err := streamIDLabelsFor(rf.Benchmark.StreamID)
-		if err != nil {
-			return nil, err
+	streamID = s.StreamID
+	if streamID == 0 {
+		if len(virtualStreamIDLabels) == 0 {
+			return 0, nil, fmt.Errorf("no top level or virtual streamID found for stream %s", s.Name)
 		}
-		labels = append(labels, l...)
-		l, err = streamIDLabelsFor(rf.Bid.StreamID)
+		streamID, err = utils.StreamIDFromLabel(virtualStreamIDLabels[0].Key)
 		if err != nil {
-			return nil, err
+			return 0, nil, fmt.Errorf("failed to parse streamID from label: %w", err)
 		}
-		labels = append(labels, l...)
-		l, err = streamIDLabelsFor(rf.Ask.StreamID)
+	}
+
+	return streamID, labels, nil
+}
+
+// streamIDLabelsFromReportFields returns a list of labels for the virtual streamIDs from the report fields.
+// This function does NOT return nil, it returns an empty slice if no labels are found. It relies on
+// ReportFields.StreamIDs so new stream types registered via jobs.RegisterStreamType don't require
+// a change here.
+func streamIDLabelsFromReportFields(rf jobs.ReportFields) ([]*ptypes.Label, error) {
+	labels := []*ptypes.Label{}
+
+	for _, sid := range rf.StreamIDs() {
+		l, err := streamIDLabelsFor(sid)
 		if err != nil {
 			return nil, err
 		}
 		labels = append(labels, l...)
-
-	default:
-		return nil, fmt.Errorf("unknown report fields type: %T", rf)
 	}
 
 	return labels, nil
@@ -177,6 +218,7 @@ func streamIDLabelsFor(sid *string) ([]*ptypes.Label, error) {
 		{
 			Key: utils.StreamIDLabel(uint32(id)),
 		},
+		utils.StreamIDValueLabel(uint32(id)),
 	}, nil
 }
 
@@ -197,17 +239,27 @@ func (f CsDistributeStreamJobSpecs) VerifyPreconditions(_ cldf.Environment, conf
 		if s.Name == "" {
 			return errors.New("name is required for each stream")
 		}
-		if !s.StreamType.Valid() {
-			return errors.New("stream type is not valid")
+		expectedReportFields, err := jobs.ReportFieldsForStreamType(s.StreamType)
+		if err != nil {
+			return fmt.Errorf("stream type is not valid: %w", err)
 		}
 		if s.ReportFields == nil {
 			return errors.New("report fields are required for each stream")
 		}
-		if s.EARequestParams.Endpoint == "" {
-			return errors.New("endpoint is required for each EARequestParam on each stream")
+		if reflect.TypeOf(s.ReportFields) != reflect.TypeOf(expectedReportFields) {
+			return fmt.Errorf("reportFields type %T does not match the registered type %T for stream type %s",
+				s.ReportFields, expectedReportFields, s.StreamType)
 		}
-		if len(s.APIs) == 0 {
-			return errors.New("at least one API is required for each stream")
+		if len(s.Datasources) == 0 {
+			return errors.New("at least one datasource is required for each stream")
+		}
+		for _, ds := range s.Datasources {
+			if ds.BridgeName == "" {
+				return errors.New("bridgeName is required for each datasource")
+			}
+			if ds.Params == nil && s.DefaultParams == nil {
+				return fmt.Errorf("datasource %s has no request params and stream has no defaultParams", ds.BridgeName)
+			}
 		}
 	}
 	if len(config.NodeNames) == 0 {
@@ -0,0 +1,195 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	jobv1 "github.com/smartcontractkit/chainlink-protos/job-distributor/v1/job"
+
+	cldf "github.com/smartcontractkit/chainlink-deployments-framework/deployment"
+)
+
+// RetryPolicy controls how CsDistributeStreamJobSpecs retries a single Offchain.ProposeJob call.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts per proposal, including the first one.
+	// Defaults to 1 (no retries) if unset.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry; it doubles after every subsequent attempt.
+	// Defaults to 500ms if unset.
+	BaseDelay time.Duration
+	// IsRetryable classifies whether a failed ProposeJob call should be retried. A nil
+	// IsRetryable treats every error as retryable.
+	IsRetryable func(error) bool
+}
+
+func (rp RetryPolicy) withDefaults() RetryPolicy {
+	if rp.MaxAttempts <= 0 {
+		rp.MaxAttempts = 1
+	}
+	if rp.BaseDelay <= 0 {
+		rp.BaseDelay = 500 * time.Millisecond
+	}
+	return rp
+}
+
+// do runs fn, retrying according to the policy until it succeeds, a non-retryable error is
+// returned, attempts are exhausted, or ctx is done.
+func (rp RetryPolicy) do(ctx context.Context, fn func() error) error {
+	rp = rp.withDefaults()
+
+	var err error
+	delay := rp.BaseDelay
+	for attempt := 1; attempt <= rp.MaxAttempts; attempt++ {
+		err = fn()
+		if err == nil {
+			return nil
+		}
+		if rp.IsRetryable != nil && !rp.IsRetryable(err) {
+			return err
+		}
+		if attempt == rp.MaxAttempts {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+	return err
+}
+
+// defaultConcurrency is used when CsDistributeStreamJobSpecsConfig.Concurrency is left unset.
+const defaultConcurrency = 1
+
+func concurrencyOrDefault(n int) int {
+	if n <= 0 {
+		return defaultConcurrency
+	}
+	return n
+}
+
+// buildProposalFunc produces the ProposeJobRequest for a single (stream, oracle node) pair. It
+// does the fetchExternalJobID + GenerateJobSpec + MarshalTOML work for that pair, and is safe to
+// run from a worker pool.
+type buildProposalFunc func() (*jobv1.ProposeJobRequest, error)
+
+// buildProposalsConcurrently runs every buildProposalFunc across a bounded worker pool, preserving
+// the input order in the returned slice. It returns the first error encountered, if any.
+func buildProposalsConcurrently(concurrency int, builders []buildProposalFunc) ([]*jobv1.ProposeJobRequest, error) {
+	concurrency = concurrencyOrDefault(concurrency)
+	if concurrency > len(builders) {
+		concurrency = len(builders)
+	}
+
+	results := make([]*jobv1.ProposeJobRequest, len(builders))
+	errs := make([]error, len(builders))
+
+	jobsCh := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobsCh {
+				results[idx], errs[idx] = builders[idx]()
+			}
+		}()
+	}
+	for idx := range builders {
+		jobsCh <- idx
+	}
+	close(jobsCh)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// proposeConcurrentlyWithRetry proposes every job concurrently (bounded by concurrency), retrying
+// each ProposeJob call according to retryPolicy. It preserves the changeset's all-or-nothing
+// semantic: if any proposal terminally fails, every proposal that had already been accepted is
+// rolled back via RevokeJob before the error is returned.
+func proposeConcurrentlyWithRetry(
+	ctx context.Context,
+	e cldf.Environment,
+	proposals []*jobv1.ProposeJobRequest,
+	concurrency int,
+	retryPolicy RetryPolicy,
+) ([]cldf.ProposedJob, error) {
+	concurrency = concurrencyOrDefault(concurrency)
+	if concurrency > len(proposals) {
+		concurrency = len(proposals)
+	}
+
+	accepted := make([]cldf.ProposedJob, len(proposals))
+	errs := make([]error, len(proposals))
+
+	jobsCh := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobsCh {
+				p := proposals[idx]
+				err := retryPolicy.do(ctx, func() error {
+					resp, proposeErr := e.Offchain.ProposeJob(ctx, p)
+					if proposeErr != nil {
+						return proposeErr
+					}
+					accepted[idx] = cldf.ProposedJob{
+						JobID: resp.GetProposal().GetJobId(),
+						Spec:  resp.GetProposal().GetSpec(),
+					}
+					return nil
+				})
+				errs[idx] = err
+			}
+		}()
+	}
+	for idx := range proposals {
+		jobsCh <- idx
+	}
+	close(jobsCh)
+	wg.Wait()
+
+	succeeded, firstErr := partitionProposeResults(accepted, errs)
+
+	if firstErr != nil {
+		for _, job := range succeeded {
+			// Best-effort rollback: a revoke failure here doesn't change the outcome, the
+			// changeset has already failed, but we still want to attempt every rollback.
+			_, _ = e.Offchain.RevokeJob(ctx, &jobv1.RevokeJobRequest{
+				IdOneof: &jobv1.RevokeJobRequest_Id{Id: job.JobID},
+			})
+		}
+		return nil, firstErr
+	}
+
+	return succeeded, nil
+}
+
+// partitionProposeResults splits the per-index propose outcome into the jobs that were accepted
+// and the first error encountered (in index order), so proposeConcurrentlyWithRetry's commit/
+// rollback decision is testable without a real Offchain client. accepted[i] is only meaningful
+// when errs[i] is nil.
+func partitionProposeResults(accepted []cldf.ProposedJob, errs []error) (succeeded []cldf.ProposedJob, firstErr error) {
+	succeeded = make([]cldf.ProposedJob, 0, len(accepted))
+	for i, err := range errs {
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		succeeded = append(succeeded, accepted[i])
+	}
+	return succeeded, firstErr
+}
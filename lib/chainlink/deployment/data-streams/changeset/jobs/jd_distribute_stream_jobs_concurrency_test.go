@@ -0,0 +1,164 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	jobv1 "github.com/smartcontractkit/chainlink-protos/job-distributor/v1/job"
+
+	cldf "github.com/smartcontractkit/chainlink-deployments-framework/deployment"
+)
+
+func TestConcurrencyOrDefault(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, defaultConcurrency, concurrencyOrDefault(0))
+	require.Equal(t, defaultConcurrency, concurrencyOrDefault(-1))
+	require.Equal(t, 5, concurrencyOrDefault(5))
+}
+
+func TestRetryPolicyDoSucceedsWithoutRetry(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	err := RetryPolicy{BaseDelay: time.Millisecond}.do(context.Background(), func() error {
+		calls++
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, calls)
+}
+
+func TestRetryPolicyDoRetriesUntilSuccess(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	err := RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}.do(context.Background(), func() error {
+		calls++
+		if calls < 3 {
+			return errors.New("transient")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+}
+
+func TestRetryPolicyDoStopsOnNonRetryableError(t *testing.T) {
+	t.Parallel()
+
+	wantErr := errors.New("fatal")
+	calls := 0
+	err := RetryPolicy{
+		MaxAttempts: 5,
+		BaseDelay:   time.Millisecond,
+		IsRetryable: func(error) bool { return false },
+	}.do(context.Background(), func() error {
+		calls++
+		return wantErr
+	})
+	require.Equal(t, wantErr, err)
+	require.Equal(t, 1, calls)
+}
+
+func TestRetryPolicyDoExhaustsAttempts(t *testing.T) {
+	t.Parallel()
+
+	wantErr := errors.New("still failing")
+	calls := 0
+	err := RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}.do(context.Background(), func() error {
+		calls++
+		return wantErr
+	})
+	require.Equal(t, wantErr, err)
+	require.Equal(t, 3, calls)
+}
+
+func TestRetryPolicyDoRespectsContextCancellation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := 0
+	err := RetryPolicy{MaxAttempts: 2, BaseDelay: time.Second}.do(ctx, func() error {
+		calls++
+		return errors.New("transient")
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, calls)
+}
+
+func TestBuildProposalsConcurrentlyPreservesOrder(t *testing.T) {
+	t.Parallel()
+
+	builders := make([]buildProposalFunc, 10)
+	for i := 0; i < len(builders); i++ {
+		i := i
+		builders[i] = func() (*jobv1.ProposeJobRequest, error) {
+			return &jobv1.ProposeJobRequest{NodeId: string(rune('a' + i))}, nil
+		}
+	}
+
+	results, err := buildProposalsConcurrently(4, builders)
+	require.NoError(t, err)
+	require.Len(t, results, len(builders))
+	for i, r := range results {
+		require.Equal(t, string(rune('a'+i)), r.NodeId)
+	}
+}
+
+func TestBuildProposalsConcurrentlyPropagatesError(t *testing.T) {
+	t.Parallel()
+
+	wantErr := errors.New("build failed")
+	builders := []buildProposalFunc{
+		func() (*jobv1.ProposeJobRequest, error) { return &jobv1.ProposeJobRequest{}, nil },
+		func() (*jobv1.ProposeJobRequest, error) { return nil, wantErr },
+		func() (*jobv1.ProposeJobRequest, error) { return &jobv1.ProposeJobRequest{}, nil },
+	}
+
+	_, err := buildProposalsConcurrently(2, builders)
+	require.Equal(t, wantErr, err)
+}
+
+func TestPartitionProposeResultsAllSucceed(t *testing.T) {
+	t.Parallel()
+
+	accepted := []cldf.ProposedJob{{JobID: "a"}, {JobID: "b"}, {JobID: "c"}}
+	errs := []error{nil, nil, nil}
+
+	succeeded, firstErr := partitionProposeResults(accepted, errs)
+	require.NoError(t, firstErr)
+	require.Equal(t, accepted, succeeded)
+}
+
+func TestPartitionProposeResultsPartialFailureExcludesFailedAndKeepsSucceeded(t *testing.T) {
+	t.Parallel()
+
+	wantErr := errors.New("propose failed")
+	accepted := []cldf.ProposedJob{{JobID: "a"}, {}, {JobID: "c"}}
+	errs := []error{nil, wantErr, nil}
+
+	succeeded, firstErr := partitionProposeResults(accepted, errs)
+	require.Equal(t, wantErr, firstErr)
+	// Both "a" and "c" were accepted by the Offchain client before "b" failed; the caller rolls
+	// these back via RevokeJob rather than leaking them into the committed output.
+	require.Equal(t, []cldf.ProposedJob{{JobID: "a"}, {JobID: "c"}}, succeeded)
+}
+
+func TestPartitionProposeResultsReturnsFirstErrorInIndexOrder(t *testing.T) {
+	t.Parallel()
+
+	firstWantErr := errors.New("first failure")
+	secondErr := errors.New("second failure")
+	accepted := make([]cldf.ProposedJob, 2)
+	errs := []error{firstWantErr, secondErr}
+
+	_, firstErr := partitionProposeResults(accepted, errs)
+	require.Equal(t, firstWantErr, firstErr)
+}
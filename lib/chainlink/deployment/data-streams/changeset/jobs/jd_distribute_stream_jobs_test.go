@@ -0,0 +1,57 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-protos/job-distributor/v1/shared/ptypes"
+
+	"github.com/smartcontractkit/chainlink/deployment/data-streams/jobs"
+	"github.com/smartcontractkit/chainlink/deployment/data-streams/utils"
+	"github.com/smartcontractkit/chainlink/deployment/data-streams/utils/pointer"
+)
+
+func TestResolveStreamIDAndLabelsTopLevelID(t *testing.T) {
+	t.Parallel()
+
+	s := jobs.StreamSpecConfig{
+		StreamID:     7,
+		Name:         "quote-stream",
+		ReportFields: jobs.QuoteReportFields{},
+	}
+
+	streamID, labels, err := resolveStreamIDAndLabels(s)
+	require.NoError(t, err)
+	require.Equal(t, uint32(7), streamID)
+	require.Contains(t, labels, utils.StreamIDValueLabel(7))
+}
+
+func TestResolveStreamIDAndLabelsVirtualIDOnly(t *testing.T) {
+	t.Parallel()
+
+	s := jobs.StreamSpecConfig{
+		Name: "quote-stream-virtual",
+		ReportFields: jobs.QuoteReportFields{
+			Benchmark: jobs.ReportFieldLLO{StreamID: pointer.To("42")},
+		},
+	}
+
+	streamID, labels, err := resolveStreamIDAndLabels(s)
+	require.NoError(t, err)
+	require.Equal(t, uint32(42), streamID)
+	require.Contains(t, labels, utils.StreamIDValueLabel(42))
+	require.Contains(t, labels, &ptypes.Label{Key: utils.StreamIDLabel(42)})
+}
+
+func TestResolveStreamIDAndLabelsNoIDAtAll(t *testing.T) {
+	t.Parallel()
+
+	s := jobs.StreamSpecConfig{
+		Name:         "no-id-stream",
+		ReportFields: jobs.QuoteReportFields{},
+	}
+
+	_, _, err := resolveStreamIDAndLabels(s)
+	require.Error(t, err)
+}
@@ -0,0 +1,67 @@
+package jobs
+
+import (
+	"errors"
+	"fmt"
+
+	cldf "github.com/smartcontractkit/chainlink-deployments-framework/deployment"
+	jobv1 "github.com/smartcontractkit/chainlink-protos/job-distributor/v1/job"
+	"github.com/smartcontractkit/chainlink-protos/job-distributor/v1/shared/ptypes"
+
+	"github.com/smartcontractkit/chainlink/deployment/data-streams/utils"
+)
+
+var _ cldf.ChangeSetV2[CsMigrateStreamIDLabelsConfig] = CsMigrateStreamIDLabels{}
+
+// CsMigrateStreamIDLabelsConfig is the configuration for re-labeling jobs that were proposed
+// before the normalized stream-id label existed.
+type CsMigrateStreamIDLabelsConfig struct {
+	// StreamIDs is the set of streams whose jobs should be migrated to the normalized label.
+	StreamIDs []uint32
+}
+
+// CsMigrateStreamIDLabels adds the normalized utils.StreamIDKey label to every job that currently
+// only carries the legacy flag-style stream-id-<N> label, so that findJobsForStreamIDs can select
+// across all of them with a single SelectorOp_IN query instead of falling back to the per-ID loop.
+type CsMigrateStreamIDLabels struct{}
+
+func (CsMigrateStreamIDLabels) Apply(e cldf.Environment, cfg CsMigrateStreamIDLabelsConfig) (cldf.ChangesetOutput, error) {
+	migratedJobs := make([]cldf.ProposedJob, 0, len(cfg.StreamIDs))
+
+	for _, sid := range cfg.StreamIDs {
+		jobsToMigrate, err := findJobsForStreamIDsLegacy(e, []uint32{sid})
+		if err != nil {
+			return cldf.ChangesetOutput{}, fmt.Errorf("failed to find legacy jobs for stream %d: %w", sid, err)
+		}
+
+		for _, job := range jobsToMigrate {
+			// UpdateJobRequest.Labels replaces a job's full label set rather than merging into it,
+			// so we have to carry the job's existing labels (DON ID, job type, the legacy
+			// stream-id-<N> label, ...) forward alongside the new one, or this migration would wipe
+			// them off every job it touches.
+			labels := append(append([]*ptypes.Label{}, job.GetLabels()...), utils.StreamIDValueLabel(sid))
+
+			_, err := e.Offchain.UpdateJob(e.GetContext(), &jobv1.UpdateJobRequest{
+				IdOneof: &jobv1.UpdateJobRequest_Id{
+					Id: job.GetId(),
+				},
+				Labels: labels,
+			})
+			if err != nil {
+				return cldf.ChangesetOutput{}, fmt.Errorf("failed to add normalized stream-id label to job %s: %w", job.GetId(), err)
+			}
+			migratedJobs = append(migratedJobs, cldf.ProposedJob{JobID: job.GetId()})
+		}
+	}
+
+	return cldf.ChangesetOutput{
+		Jobs: migratedJobs,
+	}, nil
+}
+
+func (CsMigrateStreamIDLabels) VerifyPreconditions(_ cldf.Environment, config CsMigrateStreamIDLabelsConfig) error {
+	if len(config.StreamIDs) == 0 {
+		return errors.New("streamIDs are required")
+	}
+	return nil
+}
@@ -0,0 +1,283 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"strconv"
+
+	jobv1 "github.com/smartcontractkit/chainlink-protos/job-distributor/v1/job"
+	"github.com/smartcontractkit/chainlink-protos/job-distributor/v1/shared/ptypes"
+
+	cldf "github.com/smartcontractkit/chainlink-deployments-framework/deployment"
+
+	"github.com/smartcontractkit/chainlink/deployment/data-streams/jd"
+	"github.com/smartcontractkit/chainlink/deployment/data-streams/jobs"
+	"github.com/smartcontractkit/chainlink/deployment/data-streams/utils"
+	"github.com/smartcontractkit/chainlink/deployment/data-streams/utils/pointer"
+	"github.com/smartcontractkit/chainlink/deployment/environment/devenv"
+)
+
+var _ cldf.ChangeSetV2[CsReconcileStreamJobSpecsConfig] = CsReconcileStreamJobSpecs{}
+
+// CsReconcileStreamJobSpecsConfig is the configuration for reconciling the set of stream job
+// specs deployed to a DON against a declarative Streams config, the same shape accepted by
+// CsDistributeStreamJobSpecs.
+type CsReconcileStreamJobSpecsConfig struct {
+	Filter  *jd.ListFilter
+	Streams []jobs.StreamSpecConfig
+
+	// NodeNames specifies on which nodes to reconcile the job specs.
+	NodeNames []string
+
+	// DryRun, when true, computes the reconciliation plan without proposing, revoking or
+	// re-proposing anything.
+	//
+	// TODO: the preview is returned via the same ChangesetOutput.Jobs field a committed run uses,
+	// so a caller can't tell a previewed create/update/revoke from a committed one just by
+	// inspecting the output. A distinct PreviewedJobs field needs adding to cldf.ChangesetOutput,
+	// which is owned by the chainlink-deployments-framework module rather than this repo.
+	DryRun bool
+}
+
+type CsReconcileStreamJobSpecs struct{}
+
+// reconcilePlan captures what CsReconcileStreamJobSpecs would do to bring a DON's deployed
+// stream jobs in line with the configured Streams.
+type reconcilePlan struct {
+	toCreate []*jobv1.ProposeJobRequest
+	// toUpdate holds jobs that already exist but whose rendered TOML has drifted from what the
+	// current config would generate. They are revoked and re-proposed with the same
+	// externalJobID so consumers see a stable job identity across the update.
+	toUpdate []reconcileUpdate
+	// toRevoke holds jobs deployed for streams that are no longer present in the config.
+	toRevoke []*jobv1.Job
+}
+
+// reconcileUpdate pairs the already-deployed job being replaced with the proposal that should
+// replace it.
+type reconcileUpdate struct {
+	existingJobID string
+	proposal      *jobv1.ProposeJobRequest
+}
+
+func (CsReconcileStreamJobSpecs) Apply(e cldf.Environment, cfg CsReconcileStreamJobSpecsConfig) (cldf.ChangesetOutput, error) {
+	ctx, cancel := context.WithTimeout(e.GetContext(), defaultJobSpecsTimeout)
+	defer cancel()
+
+	oracleNodes, err := jd.FetchDONOraclesFromJD(ctx, e.Offchain, cfg.Filter, cfg.NodeNames)
+	if err != nil {
+		return cldf.ChangesetOutput{}, fmt.Errorf("failed to get oracle nodes: %w", err)
+	}
+
+	plan, err := buildReconcilePlan(e, cfg, oracleNodes)
+	if err != nil {
+		return cldf.ChangesetOutput{}, fmt.Errorf("failed to build reconciliation plan: %w", err)
+	}
+
+	if cfg.DryRun {
+		previewedJobs := make([]cldf.ProposedJob, 0, len(plan.toCreate)+len(plan.toUpdate)+len(plan.toRevoke))
+		for _, p := range plan.toCreate {
+			previewedJobs = append(previewedJobs, cldf.ProposedJob{Spec: p.Spec})
+		}
+		for _, u := range plan.toUpdate {
+			previewedJobs = append(previewedJobs, cldf.ProposedJob{JobID: u.existingJobID, Spec: u.proposal.Spec})
+		}
+		for _, j := range plan.toRevoke {
+			previewedJobs = append(previewedJobs, cldf.ProposedJob{JobID: j.GetId()})
+		}
+		return cldf.ChangesetOutput{Jobs: previewedJobs}, nil
+	}
+
+	reconciledJobs := make([]cldf.ProposedJob, 0, len(plan.toCreate)+len(plan.toUpdate)+len(plan.toRevoke))
+
+	for _, j := range plan.toRevoke {
+		if _, err := e.Offchain.RevokeJob(ctx, &jobv1.RevokeJobRequest{
+			IdOneof: &jobv1.RevokeJobRequest_Id{Id: j.GetId()},
+		}); err != nil {
+			return cldf.ChangesetOutput{}, fmt.Errorf("failed to revoke stale job %s: %w", j.GetId(), err)
+		}
+		reconciledJobs = append(reconciledJobs, cldf.ProposedJob{JobID: j.GetId()})
+	}
+
+	toPropose := append([]*jobv1.ProposeJobRequest{}, plan.toCreate...)
+	for _, u := range plan.toUpdate {
+		if _, err := e.Offchain.RevokeJob(ctx, &jobv1.RevokeJobRequest{
+			IdOneof: &jobv1.RevokeJobRequest_Id{Id: u.existingJobID},
+		}); err != nil {
+			return cldf.ChangesetOutput{}, fmt.Errorf("failed to revoke outdated job %s: %w", u.existingJobID, err)
+		}
+		toPropose = append(toPropose, u.proposal)
+	}
+
+	proposedJobs, err := proposeAllOrNothing(ctx, e.Offchain, toPropose)
+	if err != nil {
+		return cldf.ChangesetOutput{}, fmt.Errorf("failed to propose reconciled jobs: %w", err)
+	}
+	reconciledJobs = append(reconciledJobs, proposedJobs...)
+
+	return cldf.ChangesetOutput{
+		Jobs: reconciledJobs,
+	}, nil
+}
+
+// buildReconcilePlan diffs the rendered TOML for every (stream, node) pair against what is
+// currently deployed on the DON, and works out which streams deployed today are no longer
+// present in cfg.Streams at all.
+func buildReconcilePlan(e cldf.Environment, cfg CsReconcileStreamJobSpecsConfig, oracleNodes []*jobv1.Node) (*reconcilePlan, error) {
+	plan := &reconcilePlan{}
+
+	configuredStreamIDs := make(map[uint32]bool, len(cfg.Streams))
+
+	for _, s := range cfg.Streams {
+		streamID, streamIDLabels, err := resolveStreamIDAndLabels(s)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve streamID for stream %s: %w", s.Name, err)
+		}
+		configuredStreamIDs[streamID] = true
+		// Carry the DON and job-type labels CsDistributeStreamJobSpecs writes, so jobs proposed here
+		// are found again by findJobsForStreamJobType.
+		streamLabels := append([]*ptypes.Label{
+			{Key: utils.DonIDLabel(cfg.Filter.DONID, cfg.Filter.DONName)},
+			{Key: devenv.LabelJobTypeKey, Value: pointer.To(devenv.LabelJobTypeValueStream)},
+		}, streamIDLabels...)
+
+		if s.Generator == nil {
+			s.Generator, err = jobs.GeneratorForStreamType(s.StreamType)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get generator for stream type %s: %w", s.StreamType, err)
+			}
+		}
+
+		for _, n := range oracleNodes {
+			existing, err := findStreamJobForNode(e, n.Id, streamID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to look up existing job for stream %d on node %s: %w", streamID, n.Id, err)
+			}
+
+			externalJobID, err := fetchExternalJobID(e, n.Id, []*ptypes.Selector{
+				{
+					Key: utils.StreamIDLabel(streamID),
+					Op:  ptypes.SelectorOp_EXIST,
+				},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get externalJobID: %w", err)
+			}
+
+			spec, err := s.Generator.GenerateJobSpec(s, externalJobID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create stream job spec: %w", err)
+			}
+			renderedSpec, err := spec.MarshalTOML()
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal stream job spec: %w", err)
+			}
+
+			req := &jobv1.ProposeJobRequest{
+				NodeId: n.Id,
+				Spec:   string(renderedSpec),
+				Labels: streamLabels,
+			}
+
+			switch {
+			case existing == nil:
+				plan.toCreate = append(plan.toCreate, req)
+			case existing.GetLatestSpec().GetDefinition() != req.Spec:
+				plan.toUpdate = append(plan.toUpdate, reconcileUpdate{
+					existingJobID: existing.GetId(),
+					proposal:      req,
+				})
+			}
+		}
+	}
+
+	deployed, err := findJobsForStreamJobType(e, cfg.Filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployed stream jobs: %w", err)
+	}
+	for _, j := range deployed {
+		sid, err := streamIDFromJobLabels(j.GetLabels())
+		if err != nil || !configuredStreamIDs[sid] {
+			plan.toRevoke = append(plan.toRevoke, j)
+		}
+	}
+
+	return plan, nil
+}
+
+// findStreamJobForNode returns the currently deployed job for a (node, streamID) pair, or nil if
+// no such job exists yet.
+func findStreamJobForNode(e cldf.Environment, nodeID string, streamID uint32) (*jobv1.Job, error) {
+	resp, err := e.Offchain.ListJobs(e.GetContext(), &jobv1.ListJobsRequest{
+		Filter: &jobv1.ListJobsRequest_Filter{
+			NodeIds: []string{nodeID},
+			Selectors: []*ptypes.Selector{
+				{
+					Key: utils.StreamIDLabel(streamID),
+					Op:  ptypes.SelectorOp_EXIST,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Jobs) == 0 {
+		return nil, nil
+	}
+	return resp.Jobs[0], nil
+}
+
+// findJobsForStreamJobType lists every stream job currently deployed for the filter's DON.
+func findJobsForStreamJobType(e cldf.Environment, filter *jd.ListFilter) ([]*jobv1.Job, error) {
+	resp, err := e.Offchain.ListJobs(e.GetContext(), &jobv1.ListJobsRequest{
+		Filter: &jobv1.ListJobsRequest_Filter{
+			Selectors: []*ptypes.Selector{
+				{
+					Key: utils.DonIDLabel(filter.DONID, filter.DONName),
+					Op:  ptypes.SelectorOp_EXIST,
+				},
+				{
+					Key: utils.StreamIDKey,
+					Op:  ptypes.SelectorOp_EXIST,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Jobs, nil
+}
+
+// streamIDFromJobLabels extracts the normalized stream ID from a job's labels.
+func streamIDFromJobLabels(labels []*ptypes.Label) (uint32, error) {
+	for _, l := range labels {
+		if l.GetKey() == utils.StreamIDKey {
+			id, err := strconv.ParseUint(l.GetValue(), 10, 32)
+			if err != nil {
+				return 0, fmt.Errorf("failed to parse stream-id label value: %w", err)
+			}
+			return uint32(id), nil
+		}
+	}
+	return 0, errors.New("no stream-id label found on job")
+}
+
+func (CsReconcileStreamJobSpecs) VerifyPreconditions(_ cldf.Environment, config CsReconcileStreamJobSpecsConfig) error {
+	if config.Filter == nil {
+		return errors.New("filter is required")
+	}
+	if config.Filter.DONID == 0 || config.Filter.DONName == "" {
+		return errors.New("DONID and DONName are required")
+	}
+	if len(config.NodeNames) == 0 {
+		return errors.New("at least one node name is required")
+	}
+	if config.Filter.NumOracleNodes != len(config.NodeNames) {
+		return fmt.Errorf("number of node names (%d) does not match filter size (%d)", len(config.NodeNames), config.Filter.NumOracleNodes)
+	}
+	return nil
+}
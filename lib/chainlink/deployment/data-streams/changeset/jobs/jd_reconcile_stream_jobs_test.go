@@ -0,0 +1,47 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-protos/job-distributor/v1/shared/ptypes"
+
+	"github.com/smartcontractkit/chainlink/deployment/data-streams/utils"
+	"github.com/smartcontractkit/chainlink/deployment/data-streams/utils/pointer"
+)
+
+func TestStreamIDFromJobLabelsFindsNormalizedLabel(t *testing.T) {
+	t.Parallel()
+
+	labels := []*ptypes.Label{
+		{Key: utils.DonIDLabel(1, "don-1"), Value: pointer.To("don-1")},
+		utils.StreamIDValueLabel(42),
+	}
+
+	sid, err := streamIDFromJobLabels(labels)
+	require.NoError(t, err)
+	require.Equal(t, uint32(42), sid)
+}
+
+func TestStreamIDFromJobLabelsMissingLabel(t *testing.T) {
+	t.Parallel()
+
+	labels := []*ptypes.Label{
+		{Key: utils.DonIDLabel(1, "don-1"), Value: pointer.To("don-1")},
+	}
+
+	_, err := streamIDFromJobLabels(labels)
+	require.Error(t, err)
+}
+
+func TestStreamIDFromJobLabelsInvalidValue(t *testing.T) {
+	t.Parallel()
+
+	labels := []*ptypes.Label{
+		{Key: utils.StreamIDKey, Value: pointer.To("not-a-number")},
+	}
+
+	_, err := streamIDFromJobLabels(labels)
+	require.Error(t, err)
+}
@@ -3,12 +3,15 @@ package jobs
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	cldf "github.com/smartcontractkit/chainlink-deployments-framework/deployment"
 	jobv1 "github.com/smartcontractkit/chainlink-protos/job-distributor/v1/job"
 	"github.com/smartcontractkit/chainlink-protos/job-distributor/v1/shared/ptypes"
 
 	"github.com/smartcontractkit/chainlink/deployment/data-streams/utils"
+	"github.com/smartcontractkit/chainlink/deployment/data-streams/utils/pointer"
 )
 
 var _ cldf.ChangeSetV2[CsRevokeJobSpecsConfig] = CsRevokeJobSpecs{}
@@ -24,6 +27,16 @@ type CsRevokeJobSpecsConfig struct {
 	UUIDs []string
 
 	StreamIDs []uint32
+
+	// DryRun, when true, collects the jobs that would be revoked without actually calling
+	// Offchain.RevokeJob, so operators can confirm the blast radius before committing.
+	//
+	// TODO: previewed revocations come back via the same ChangesetOutput.Jobs field a committed
+	// run uses, with only JobID set (a real revocation entry also carries the revoked proposal's
+	// Spec). Distinguishing them more clearly needs a PreviewedJobs field on
+	// cldf.ChangesetOutput, which is owned by the chainlink-deployments-framework module rather
+	// than this repo.
+	DryRun bool
 }
 
 type CsRevokeJobSpecs struct{}
@@ -34,6 +47,18 @@ func (CsRevokeJobSpecs) Apply(e cldf.Environment, cfg CsRevokeJobSpecsConfig) (c
 		return cldf.ChangesetOutput{}, fmt.Errorf("failed to find jobs: %w", err)
 	}
 
+	if cfg.DryRun {
+		previewedJobs := make([]cldf.ProposedJob, 0, len(jobs))
+		for _, job := range jobs {
+			previewedJobs = append(previewedJobs, cldf.ProposedJob{
+				JobID: job.GetId(),
+			})
+		}
+		return cldf.ChangesetOutput{
+			Jobs: previewedJobs,
+		}, nil
+	}
+
 	revokedJobs := make([]cldf.ProposedJob, 0, len(jobs))
 	for _, job := range jobs {
 		resp, err := e.Offchain.RevokeJob(e.GetContext(), &jobv1.RevokeJobRequest{
@@ -82,10 +107,75 @@ func findJobsForUUIDs(e cldf.Environment, uuids []string) ([]*jobv1.Job, error)
 	return jobsResp.Jobs, nil
 }
 
+// findJobsForStreamIDs finds jobs for the given stream IDs in a single ListJobs call, using the
+// normalized stream-id label (utils.StreamIDKey) that CsDistributeStreamJobSpecs writes on every
+// job it proposes. Jobs proposed before that label existed only carry the legacy flag-style
+// stream-id-<N> label, so any stream ID the batched query didn't cover falls back to the old
+// per-streamID EXIST loop, rather than gating the fallback on the batched result being empty (a
+// mix of migrated and unmigrated stream IDs would otherwise silently drop the unmigrated ones).
 func findJobsForStreamIDs(e cldf.Environment, streamIDs []uint32) ([]*jobv1.Job, error) {
+	values := make([]string, len(streamIDs))
+	for i, sid := range streamIDs {
+		values[i] = strconv.FormatUint(uint64(sid), 10)
+	}
+
+	jobsResp, err := e.Offchain.ListJobs(e.GetContext(), &jobv1.ListJobsRequest{
+		Filter: &jobv1.ListJobsRequest_Filter{
+			Selectors: []*ptypes.Selector{
+				{
+					Key:   utils.StreamIDKey,
+					Op:    ptypes.SelectorOp_IN,
+					Value: pointer.To(strings.Join(values, ",")),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	}
+
+	uncovered := streamIDsNotCoveredBy(streamIDs, jobsResp.Jobs)
+	if len(uncovered) == 0 {
+		return jobsResp.Jobs, nil
+	}
+
+	legacyJobs, err := findJobsForStreamIDsLegacy(e, uncovered)
+	if err != nil {
+		return nil, err
+	}
+	return append(jobsResp.Jobs, legacyJobs...), nil
+}
+
+// streamIDsNotCoveredBy returns the subset of streamIDs for which none of jobs carries a matching
+// utils.StreamIDKey label, i.e. the stream IDs the batched IN query didn't find anything for.
+func streamIDsNotCoveredBy(streamIDs []uint32, jobs []*jobv1.Job) []uint32 {
+	covered := make(map[uint32]bool, len(streamIDs))
+	for _, job := range jobs {
+		for _, label := range job.GetLabels() {
+			if label.GetKey() != utils.StreamIDKey {
+				continue
+			}
+			sid, err := strconv.ParseUint(label.GetValue(), 10, 32)
+			if err != nil {
+				continue
+			}
+			covered[uint32(sid)] = true
+		}
+	}
+
+	var uncovered []uint32
+	for _, sid := range streamIDs {
+		if !covered[sid] {
+			uncovered = append(uncovered, sid)
+		}
+	}
+	return uncovered
+}
+
+// findJobsForStreamIDsLegacy collects jobs one stream ID at a time using the flag-style
+// stream-id-<N> label, for jobs proposed before the normalized stream-id label was introduced.
+func findJobsForStreamIDsLegacy(e cldf.Environment, streamIDs []uint32) ([]*jobv1.Job, error) {
 	var jobs []*jobv1.Job
-	// We need to collect the jobs for each stream ID separately because the label we use is a flag and we cannot
-	// select by "OR" logic.
 	for _, sid := range streamIDs {
 		jobsResp, err := e.Offchain.ListJobs(e.GetContext(), &jobv1.ListJobsRequest{
 			Filter: &jobv1.ListJobsRequest_Filter{
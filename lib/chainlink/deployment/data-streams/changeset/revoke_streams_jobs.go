@@ -0,0 +1,35 @@
+package changeset
+
+import (
+	"errors"
+
+	cldf "github.com/smartcontractkit/chainlink-deployments-framework/deployment"
+
+	jobschangeset "github.com/smartcontractkit/chainlink/deployment/data-streams/changeset/jobs"
+)
+
+var _ cldf.ChangeSetV2[RevokeStreamsJobsConfig] = CsRevokeStreamsJobs{}
+
+// RevokeStreamsJobsConfig is the configuration for CsRevokeStreamsJobs.
+type RevokeStreamsJobsConfig struct {
+	// ExternalJobIDs is the set of externalJobIDs (as deployed by CsDeployStreamsJobs) to delete.
+	ExternalJobIDs []string
+}
+
+// CsRevokeStreamsJobs deletes the jobs deployed by CsDeployStreamsJobs, identified by their
+// externalJobID. It's a thin wrapper around jobs.CsRevokeJobSpecs so the two changesets that
+// manage a job's lifecycle (deploy/revoke) stay in sync.
+type CsRevokeStreamsJobs struct{}
+
+func (CsRevokeStreamsJobs) Apply(e cldf.Environment, cfg RevokeStreamsJobsConfig) (cldf.ChangesetOutput, error) {
+	return jobschangeset.CsRevokeJobSpecs{}.Apply(e, jobschangeset.CsRevokeJobSpecsConfig{
+		UUIDs: cfg.ExternalJobIDs,
+	})
+}
+
+func (CsRevokeStreamsJobs) VerifyPreconditions(_ cldf.Environment, config RevokeStreamsJobsConfig) error {
+	if len(config.ExternalJobIDs) == 0 {
+		return errors.New("externalJobIDs are required")
+	}
+	return nil
+}
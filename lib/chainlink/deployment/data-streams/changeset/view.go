@@ -3,9 +3,6 @@ package changeset
 import (
 	"encoding/json"
 
-	chainselectors "github.com/smartcontractkit/chain-selectors"
-
-	cldf_chain "github.com/smartcontractkit/chainlink-deployments-framework/chain"
 	"github.com/smartcontractkit/chainlink-deployments-framework/deployment"
 
 	dsstate "github.com/smartcontractkit/chainlink/deployment/data-streams/changeset/state"
@@ -15,7 +12,10 @@ import (
 var _ deployment.ViewState = ViewDataStreams
 
 func ViewDataStreams(e deployment.Environment) (json.Marshaler, error) {
-	return ViewDataStreamsChain(e, e.BlockChains.ListChainSelectors(cldf_chain.WithFamily(chainselectors.FamilyEVM)))
+	// Don't restrict to FamilyEVM: TokenApprovers and other data-streams changesets now dispatch
+	// per chain family (see deployment/common/changeset.TokenApprovers), so the view shouldn't be
+	// blind to non-EVM chains state.View already knows how to render.
+	return ViewDataStreamsChain(e, e.BlockChains.ListChainSelectors())
 }
 
 func ViewDataStreamsChain(e deployment.Environment, chainselectors []uint64) (json.Marshaler, error) {
@@ -27,6 +27,12 @@ func ViewDataStreamsChain(e deployment.Environment, chainselectors []uint64) (js
 	if err != nil {
 		return nil, err
 	}
+	// TODO: this view doesn't surface the timelock/MCMS owner address per chain, so a caller of
+	// changeset.ApproveTokenWithMode still has to know out-of-band whether a given chain's
+	// router/token is deployer-owned or has been handed off to a timelock, rather than reading
+	// that off ViewDataStreams. Adding it means extending dsstate.LoadOnchainState/state.View to
+	// load and expose the owner address, and dsView.DataStreamsView's per-chain shape to carry
+	// it; both packages are owned elsewhere and aren't available in this checkout to extend.
 	return dsView.DataStreamsView{
 		Chains: chainView,
 	}, nil
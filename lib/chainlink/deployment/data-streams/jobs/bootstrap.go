@@ -0,0 +1,57 @@
+package jobs
+
+import (
+	"github.com/google/uuid"
+	"github.com/pelletier/go-toml/v2"
+)
+
+// JobSpecTypeBootstrap is the job type for the single bootstrap job every DON needs so its
+// oracles can discover each other before running OCR2/LLO.
+const JobSpecTypeBootstrap = "bootstrap"
+
+// BootstrapJobSpecConfig describes the bootstrap node for a DON.
+type BootstrapJobSpecConfig struct {
+	Name string
+
+	// ContractAddress is the on-chain contract the bootstrap job watches for config changes.
+	ContractAddress string
+	// ChainID is the chain on which ContractAddress lives.
+	ChainID string
+	// RelaySelector identifies which relay plugin (e.g. "evm") to use for RelayConfig.
+	RelaySelector string
+}
+
+// BootstrapJobSpec is a rendered bootstrap job spec.
+type BootstrapJobSpec struct {
+	BaseJobSpec
+
+	ContractID  string            `toml:"contractID"`
+	Relay       string            `toml:"relay"`
+	RelayConfig map[string]string `toml:"relayConfig"`
+}
+
+func (s *BootstrapJobSpec) MarshalTOML() ([]byte, error) {
+	return toml.Marshal(s)
+}
+
+// BootstrapJobSpecGenerator generates the bootstrap job spec for a DON.
+type BootstrapJobSpecGenerator struct{}
+
+func (BootstrapJobSpecGenerator) GenerateJobSpec(cfg BootstrapJobSpecConfig, externalJobID uuid.UUID) (*BootstrapJobSpec, error) {
+	if externalJobID == uuid.Nil {
+		externalJobID = uuid.New()
+	}
+	return &BootstrapJobSpec{
+		BaseJobSpec: BaseJobSpec{
+			Name:          cfg.Name,
+			Type:          JobSpecTypeBootstrap,
+			SchemaVersion: 1,
+			ExternalJobID: externalJobID,
+		},
+		ContractID: cfg.ContractAddress,
+		Relay:      cfg.RelaySelector,
+		RelayConfig: map[string]string{
+			"chainID": cfg.ChainID,
+		},
+	}, nil
+}
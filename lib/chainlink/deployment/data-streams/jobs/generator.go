@@ -6,21 +6,67 @@ import (
 	"github.com/google/uuid"
 )
 
-// JobSpecGenerator knows how to generate job specs for each stream type.
-// It doesn't cover bootstrap and LLO job specs.
+// JobSpecGenerator knows how to generate job specs for each stream type. Bootstrap and LLO job
+// specs have a different shape (they don't key off a StreamType), so they're generated through
+// BootstrapJobSpecGenerator and LLOJobSpecGenerator instead, selected via JobSpecKind.
 type JobSpecGenerator interface {
 	GenerateJobSpec(ssc StreamSpecConfig, externalJobID uuid.UUID) (*StreamJobSpec, error)
 }
 
+// JobSpecKind identifies which generator produces a job spec in a JobSpecConfig.
+type JobSpecKind string
+
+const (
+	JobSpecKindStream    JobSpecKind = "stream"
+	JobSpecKindBootstrap JobSpecKind = "bootstrap"
+	JobSpecKindLLO       JobSpecKind = "llo"
+)
+
+// JobSpecConfig is the declarative input for producing the full set of job specs a DON needs:
+// one bootstrap job, one LLO oracle job, and the stream jobs the LLO job's channels aggregate.
+// A config can omit Bootstrap/LLO if the changeset applying it only manages stream jobs.
+type JobSpecConfig struct {
+	Bootstrap *BootstrapJobSpecConfig
+	LLO       *LLOJobSpecConfig
+	Streams   []StreamSpecConfig
+}
+
+// streamTypeRegistration pairs a JobSpecGenerator with a ReportFields prototype for a StreamType,
+// so callers can both generate specs for, and validate configs against, a stream type without this
+// package hard-coding every shape that exists.
+type streamTypeRegistration struct {
+	generator         JobSpecGenerator
+	reportFieldsProto ReportFields
+}
+
+var streamTypeRegistry = map[StreamType]streamTypeRegistration{
+	StreamTypeQuote:  {generator: &QuoteStreamJobSpecGenerator{}, reportFieldsProto: QuoteReportFields{}},
+	StreamTypeMedian: {generator: MedianStreamJobSpecGenerator{}, reportFieldsProto: MedianReportFields{}},
+}
+
+// RegisterStreamType registers a JobSpecGenerator and a ReportFields prototype for a StreamType,
+// so external callers can plug in custom stream shapes (e.g. RWA, PoR variants, multi-leg quotes)
+// without patching this package. Registering an already-known StreamType overwrites it.
+func RegisterStreamType(t StreamType, gen JobSpecGenerator, rfProto ReportFields) {
+	streamTypeRegistry[t] = streamTypeRegistration{generator: gen, reportFieldsProto: rfProto}
+}
+
 func GeneratorForStreamType(st StreamType) (JobSpecGenerator, error) {
-	switch st {
-	case StreamTypeQuote:
-		return &QuoteStreamJobSpecGenerator{}, nil
-	case StreamTypeMedian:
-		return MedianStreamJobSpecGenerator{}, nil
-	default:
+	reg, ok := streamTypeRegistry[st]
+	if !ok {
 		return nil, fmt.Errorf("unsupported stream type: %s", st)
 	}
+	return reg.generator, nil
+}
+
+// ReportFieldsForStreamType returns the ReportFields prototype registered for a StreamType, used
+// to validate that a StreamSpecConfig's ReportFields matches its declared StreamType.
+func ReportFieldsForStreamType(st StreamType) (ReportFields, error) {
+	reg, ok := streamTypeRegistry[st]
+	if !ok {
+		return nil, fmt.Errorf("unsupported stream type: %s", st)
+	}
+	return reg.reportFieldsProto, nil
 }
 
 type QuoteStreamJobSpecGenerator struct{}
@@ -39,13 +85,9 @@ func (QuoteStreamJobSpecGenerator) GenerateJobSpec(ssc StreamSpecConfig, externa
 		StreamID: ssc.StreamID,
 	}
 
-	datasources := make([]Datasource, len(ssc.APIs))
-	params := ssc.EARequestParams
-	for i, api := range ssc.APIs {
-		datasources[i] = Datasource{
-			BridgeName: api,
-			ReqData:    fmt.Sprintf(`"{\"data\":{\"endpoint\":\"%s\",\"from\":\"%s\",\"to\":\"%s\"}}"`, params.Endpoint, params.From, params.To),
-		}
+	datasources, err := resolveDatasources(ssc.Datasources, ssc.DefaultParams)
+	if err != nil {
+		return nil, err
 	}
 
 	base := BaseObservationSource{
@@ -57,6 +99,36 @@ func (QuoteStreamJobSpecGenerator) GenerateJobSpec(ssc StreamSpecConfig, externa
 	return spec, err
 }
 
+// resolveDatasources builds the rendered Datasources for an observation source from a stream's
+// DatasourceSpecs, merging each one's Params with defaultParams before rendering its ReqData.
+func resolveDatasources(specs []DatasourceSpec, defaultParams RequestParamsBuilder) ([]Datasource, error) {
+	datasources := make([]Datasource, len(specs))
+	for i, ds := range specs {
+		params := ds.Params
+		if params == nil {
+			params = defaultParams
+		} else if defaultParams != nil {
+			params = params.Merge(defaultParams)
+		}
+		if params == nil {
+			return nil, fmt.Errorf("datasource %s has no request params configured", ds.BridgeName)
+		}
+		reqData, err := params.BuildReqData()
+		if err != nil {
+			return nil, fmt.Errorf("failed to build request data for datasource %s: %w", ds.BridgeName, err)
+		}
+		datasources[i] = Datasource{
+			BridgeName:  ds.BridgeName,
+			ReqData:     reqData,
+			Timeout:     ds.Timeout,
+			RetryPolicy: ds.RetryPolicy,
+			PriceMin:    ds.PriceMin,
+			PriceMax:    ds.PriceMax,
+		}
+	}
+	return datasources, nil
+}
+
 type MedianStreamJobSpecGenerator struct{}
 
 func (MedianStreamJobSpecGenerator) GenerateJobSpec(ssc StreamSpecConfig, externalJobID uuid.UUID) (spec *StreamJobSpec, err error) {
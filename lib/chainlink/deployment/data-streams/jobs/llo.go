@@ -0,0 +1,62 @@
+package jobs
+
+import (
+	"github.com/google/uuid"
+	"github.com/pelletier/go-toml/v2"
+)
+
+// JobSpecTypeLLO is the job type for the OCR2/LLO oracle job that aggregates a DON's stream jobs
+// into channel reports.
+const JobSpecTypeLLO = "offchainreporting2"
+
+// ChannelDefinition describes one LLO channel: a report aggregating a fixed set of streams.
+type ChannelDefinition struct {
+	ChannelID uint32
+	// StreamIDs are the streams (produced by QuoteStreamJobSpecGenerator/MedianStreamJobSpecGenerator)
+	// this channel aggregates into its report.
+	StreamIDs []uint32
+	// ReportFormat selects the on-chain report encoding for this channel, e.g. "json", "evm_abi".
+	ReportFormat string
+}
+
+// LLOJobSpecConfig is the configuration for an LLO oracle job.
+type LLOJobSpecConfig struct {
+	Name     string
+	Channels []ChannelDefinition
+}
+
+// LLOJobSpec is a rendered LLO oracle job spec.
+type LLOJobSpec struct {
+	BaseJobSpec
+
+	ObservationSource string `toml:"observationSource,multiline,omitempty"`
+}
+
+func (s *LLOJobSpec) MarshalTOML() ([]byte, error) {
+	return toml.Marshal(s)
+}
+
+// LLOJobSpecGenerator generates the LLO oracle job spec that references the stream jobs produced
+// by the per-stream generators (QuoteStreamJobSpecGenerator, MedianStreamJobSpecGenerator).
+type LLOJobSpecGenerator struct{}
+
+func (LLOJobSpecGenerator) GenerateJobSpec(cfg LLOJobSpecConfig, externalJobID uuid.UUID) (*LLOJobSpec, error) {
+	if externalJobID == uuid.Nil {
+		externalJobID = uuid.New()
+	}
+
+	rendered, err := renderTemplate("osrc_llo_v1.go.tmpl", cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LLOJobSpec{
+		BaseJobSpec: BaseJobSpec{
+			Name:          cfg.Name,
+			Type:          JobSpecTypeLLO,
+			SchemaVersion: 1,
+			ExternalJobID: externalJobID,
+		},
+		ObservationSource: rendered,
+	}, nil
+}
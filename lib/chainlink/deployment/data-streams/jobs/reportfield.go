@@ -1,7 +1,13 @@
 package jobs
 
+// ReportFields describes the shape of a report's fields for a given StreamType. Custom stream
+// types register their own implementation via RegisterStreamType.
 type ReportFields interface {
 	GetStreamType() StreamType
+	// StreamIDs returns the virtual stream ID assigned to each field, in a fixed order set by the
+	// implementation (QuoteReportFields: Benchmark, Bid, Ask). An entry is nil if that particular
+	// field doesn't have its own stream ID.
+	StreamIDs() []*string
 }
 
 type ReportFieldLLO struct {
@@ -20,6 +26,10 @@ func (quote QuoteReportFields) GetStreamType() StreamType {
 	return StreamTypeQuote
 }
 
+func (quote QuoteReportFields) StreamIDs() []*string {
+	return []*string{quote.Benchmark.StreamID, quote.Bid.StreamID, quote.Ask.StreamID}
+}
+
 type MedianReportFields struct {
 	Benchmark ReportFieldLLO
 }
@@ -27,3 +37,7 @@ type MedianReportFields struct {
 func (median MedianReportFields) GetStreamType() StreamType {
 	return StreamTypeMedian
 }
+
+func (median MedianReportFields) StreamIDs() []*string {
+	return []*string{median.Benchmark.StreamID}
+}
@@ -0,0 +1,130 @@
+package jobs
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// RequestParamsBuilder builds the EA request-data payload for a single datasource. Implementations
+// let a stream mix datasources with different request shapes (off-chain EA, on-chain DEX pool,
+// hand-written JSON) instead of assuming every datasource looks like a {endpoint,from,to} EA call.
+type RequestParamsBuilder interface {
+	// BuildReqData renders the datasource's ReqData, already TOML-quoted the way the observation
+	// source templates expect.
+	BuildReqData() (string, error)
+	// Merge returns a copy of this builder with any fields it leaves unset filled in from
+	// defaults. Implementations should ignore defaults of a different concrete type.
+	Merge(defaults RequestParamsBuilder) RequestParamsBuilder
+}
+
+// RequestParamsBuilderFactory produces a zero-value RequestParamsBuilder of a registered kind, for
+// callers that construct a StreamSpecConfig from serialized config rather than Go literals.
+type RequestParamsBuilderFactory func() RequestParamsBuilder
+
+var requestParamsBuilderRegistry = map[string]RequestParamsBuilderFactory{
+	"fromTo":  func() RequestParamsBuilder { return FromToParams{} },
+	"dexPool": func() RequestParamsBuilder { return DEXPoolParams{} },
+	"rawJSON": func() RequestParamsBuilder { return RawJSONParams{} },
+}
+
+// RegisterRequestParamsBuilder registers a named RequestParamsBuilderFactory, so external callers
+// can plug in custom EA request shapes without patching this package. Registering an already-known
+// name overwrites it.
+func RegisterRequestParamsBuilder(name string, factory RequestParamsBuilderFactory) {
+	requestParamsBuilderRegistry[name] = factory
+}
+
+// RequestParamsBuilderFor returns a zero-value RequestParamsBuilder for the given registered name.
+func RequestParamsBuilderFor(name string) (RequestParamsBuilder, error) {
+	factory, ok := requestParamsBuilderRegistry[name]
+	if !ok {
+		return nil, fmt.Errorf("unregistered request params builder: %s", name)
+	}
+	return factory(), nil
+}
+
+// FromToParams is the original EA request shape: {"data":{"endpoint":...,"from":...,"to":...}}.
+type FromToParams struct {
+	Endpoint string `json:"endpoint"`
+	From     string `json:"from"`
+	To       string `json:"to"`
+}
+
+func (p FromToParams) BuildReqData() (string, error) {
+	return buildReqData(struct {
+		Data FromToParams `json:"data"`
+	}{p})
+}
+
+func (p FromToParams) Merge(defaults RequestParamsBuilder) RequestParamsBuilder {
+	d, ok := defaults.(FromToParams)
+	if !ok {
+		return p
+	}
+	if p.Endpoint == "" {
+		p.Endpoint = d.Endpoint
+	}
+	if p.From == "" {
+		p.From = d.From
+	}
+	if p.To == "" {
+		p.To = d.To
+	}
+	return p
+}
+
+// DEXPoolParams requests an on-chain DEX pool price, for streams backed by an on-chain adapter
+// rather than an off-chain EA.
+type DEXPoolParams struct {
+	ContractAddress string `json:"contractAddress"`
+	PoolFee         string `json:"poolFee"`
+	Network         string `json:"network"`
+}
+
+func (p DEXPoolParams) BuildReqData() (string, error) {
+	return buildReqData(struct {
+		Data DEXPoolParams `json:"data"`
+	}{p})
+}
+
+func (p DEXPoolParams) Merge(defaults RequestParamsBuilder) RequestParamsBuilder {
+	d, ok := defaults.(DEXPoolParams)
+	if !ok {
+		return p
+	}
+	if p.ContractAddress == "" {
+		p.ContractAddress = d.ContractAddress
+	}
+	if p.PoolFee == "" {
+		p.PoolFee = d.PoolFee
+	}
+	if p.Network == "" {
+		p.Network = d.Network
+	}
+	return p
+}
+
+// RawJSONParams passes a pre-built ReqData through unchanged, for EAs whose request body doesn't
+// fit either of the structured builders above.
+type RawJSONParams struct {
+	ReqData string
+}
+
+func (p RawJSONParams) BuildReqData() (string, error) {
+	return p.ReqData, nil
+}
+
+func (p RawJSONParams) Merge(RequestParamsBuilder) RequestParamsBuilder {
+	return p
+}
+
+// buildReqData marshals v to JSON and TOML-quotes it, matching the ReqData shape the observation
+// source templates expect (a quoted JSON string, not a raw TOML inline table).
+func buildReqData(v any) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Quote(string(b)), nil
+}
@@ -0,0 +1,87 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromToParamsMerge(t *testing.T) {
+	t.Parallel()
+
+	defaults := FromToParams{Endpoint: "crypto", From: "BTC", To: "USD"}
+	partial := FromToParams{From: "ETH"}
+
+	merged := partial.Merge(defaults)
+
+	require.Equal(t, FromToParams{Endpoint: "crypto", From: "ETH", To: "USD"}, merged)
+}
+
+func TestFromToParamsBuildReqData(t *testing.T) {
+	t.Parallel()
+
+	reqData, err := FromToParams{Endpoint: "crypto", From: "BTC", To: "USD"}.BuildReqData()
+	require.NoError(t, err)
+	require.Equal(t, `"{\"data\":{\"endpoint\":\"crypto\",\"from\":\"BTC\",\"to\":\"USD\"}}"`, reqData)
+}
+
+func TestDEXPoolParamsMerge(t *testing.T) {
+	t.Parallel()
+
+	defaults := DEXPoolParams{Network: "ethereum-mainnet"}
+	partial := DEXPoolParams{ContractAddress: "0xabc", PoolFee: "500"}
+
+	merged := partial.Merge(defaults)
+
+	require.Equal(t, DEXPoolParams{ContractAddress: "0xabc", PoolFee: "500", Network: "ethereum-mainnet"}, merged)
+}
+
+func TestRawJSONParamsBuildReqData(t *testing.T) {
+	t.Parallel()
+
+	reqData, err := RawJSONParams{ReqData: `"{\"data\":{}}"`}.BuildReqData()
+	require.NoError(t, err)
+	require.Equal(t, `"{\"data\":{}}"`, reqData)
+}
+
+func TestRegisterRequestParamsBuilder(t *testing.T) {
+	RegisterRequestParamsBuilder("test-kind", func() RequestParamsBuilder { return RawJSONParams{} })
+
+	builder, err := RequestParamsBuilderFor("test-kind")
+	require.NoError(t, err)
+	require.Equal(t, RawJSONParams{}, builder)
+
+	_, err = RequestParamsBuilderFor("unregistered-kind")
+	require.Error(t, err)
+}
+
+func TestResolveDatasources(t *testing.T) {
+	t.Parallel()
+
+	specs := []DatasourceSpec{
+		{BridgeName: "bridge-api1", Params: FromToParams{From: "BTC"}},
+		{BridgeName: "bridge-api2", Params: DEXPoolParams{ContractAddress: "0xabc"}, PriceMin: "0", PriceMax: "1000000"},
+	}
+	defaults := FromToParams{Endpoint: "crypto", To: "USD"}
+
+	datasources, err := resolveDatasources(specs, defaults)
+	require.NoError(t, err)
+	require.Len(t, datasources, 2)
+
+	require.Equal(t, "bridge-api1", datasources[0].BridgeName)
+	require.Equal(t, `"{\"data\":{\"endpoint\":\"crypto\",\"from\":\"BTC\",\"to\":\"USD\"}}"`, datasources[0].ReqData)
+
+	require.Equal(t, "bridge-api2", datasources[1].BridgeName)
+	require.Equal(t, "0", datasources[1].PriceMin)
+	require.Equal(t, "1000000", datasources[1].PriceMax)
+	// DEXPoolParams.Merge ignores defaults of a different concrete type, so ContractAddress
+	// survives unchanged and the request data only reflects the DEX pool fields.
+	require.Equal(t, `"{\"contractAddress\":\"0xabc\",\"poolFee\":\"\",\"network\":\"\"}"`, datasources[1].ReqData)
+}
+
+func TestResolveDatasourcesMissingParams(t *testing.T) {
+	t.Parallel()
+
+	_, err := resolveDatasources([]DatasourceSpec{{BridgeName: "bridge-api1"}}, nil)
+	require.Error(t, err)
+}
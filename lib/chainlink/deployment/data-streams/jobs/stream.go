@@ -9,6 +9,15 @@ import (
 type Datasource struct {
 	BridgeName string
 	ReqData    string
+
+	// Timeout overrides this datasource's HTTP timeout (e.g. "10s"). Empty means the node default.
+	Timeout string
+	// RetryPolicy overrides this datasource's retry policy. Empty means the node default.
+	RetryPolicy string
+	// PriceMin/PriceMax reject this datasource's observation if it falls outside the bounds.
+	// Empty means unbounded.
+	PriceMin string
+	PriceMax string
 }
 
 type BaseObservationSource struct {
@@ -23,9 +32,11 @@ type StreamSpecConfig struct {
 	Name       string
 	StreamType StreamType
 	// ReportFields should be QuoteReportFields, MedianReportFields, etc., based on the stream type.
-	ReportFields    ReportFields
-	EARequestParams EARequestParams
-	APIs            []string
+	ReportFields ReportFields
+	Datasources  []DatasourceSpec
+	// DefaultParams is merged into any Datasource's Params that leaves fields unset, so a stream
+	// with several EA-backed datasources doesn't need to repeat shared fields like From/To on each.
+	DefaultParams RequestParamsBuilder
 
 	// Generator allows us to specify a custom job spec generator. We might want to do that in case we need to modify
 	// the way this particular job is generated, or we might want to provide a generator for a custom stream type.
@@ -34,10 +45,21 @@ type StreamSpecConfig struct {
 	Generator JobSpecGenerator
 }
 
-type EARequestParams struct {
-	Endpoint string `json:"endpoint"`
-	From     string `json:"from"`
-	To       string `json:"to"`
+// DatasourceSpec configures a single datasource backing a stream's observation source: which
+// bridge to query, how to build that bridge's request payload, and optional per-datasource
+// overrides for the rendered observation source task.
+type DatasourceSpec struct {
+	BridgeName string
+	Params     RequestParamsBuilder
+
+	// Timeout overrides this datasource's HTTP timeout (e.g. "10s"). Empty means the node default.
+	Timeout string
+	// RetryPolicy overrides this datasource's retry policy. Empty means the node default.
+	RetryPolicy string
+	// PriceMin/PriceMax reject this datasource's observation if it falls outside the bounds.
+	// Empty means unbounded.
+	PriceMin string
+	PriceMax string
 }
 
 type StreamJobSpec struct {
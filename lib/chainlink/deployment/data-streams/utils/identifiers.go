@@ -4,10 +4,21 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+
+	"github.com/smartcontractkit/chainlink-protos/job-distributor/v1/shared/ptypes"
+
+	"github.com/smartcontractkit/chainlink/deployment/data-streams/utils/pointer"
 )
 
 const (
 	ProductLabel = "data-streams"
+
+	// StreamIDKey is the normalized label key used to tag a job with the stream ID(s) it serves.
+	// Unlike the legacy flag-style StreamIDLabel (stream-id-<N>, one key per streamID, whose value
+	// is either unset or the stream name),
+	// this key carries the stream ID as its value, which allows a single ListJobs call to select
+	// many streams at once with SelectorOp_IN instead of one EXIST round-trip per stream.
+	StreamIDKey = "stream-id"
 )
 
 // DonIDLabel generates a unique identifier for a DON based on its ID and name.
@@ -22,6 +33,15 @@ func StreamIDLabel(streamID uint32) string {
 	return fmt.Sprintf("stream-id-%d", streamID)
 }
 
+// StreamIDValueLabel returns the normalized stream-id label (key: StreamIDKey, value: streamID)
+// written alongside the legacy StreamIDLabel by CsDistributeStreamJobSpecs.
+func StreamIDValueLabel(streamID uint32) *ptypes.Label {
+	return &ptypes.Label{
+		Key:   StreamIDKey,
+		Value: pointer.To(strconv.FormatUint(uint64(streamID), 10)),
+	}
+}
+
 func StreamIDFromLabel(streamIDLabel string) (uint32, error) {
 	matches := regexp.MustCompile(`stream-id-([0-9]+)`).FindStringSubmatch(streamIDLabel)
 	if len(matches) != 2 {